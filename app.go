@@ -0,0 +1,112 @@
+package main
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/basicauth"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+
+	"github.com/MarkTaylor-KandC/golang-microservices-fiber-example/config"
+	"github.com/MarkTaylor-KandC/golang-microservices-fiber-example/middleware/bodydump"
+	"github.com/MarkTaylor-KandC/golang-microservices-fiber-example/router"
+)
+
+// namedMiddleware maps the middleware names used in config.RouteConfig's
+// per-route Middleware list to the fiber.Handler that implements them.
+// It is rebuilt on every Setup() call rather than cached at package
+// init, since some of these (limiter.New() in particular) hold their
+// own internal per-client state and must not be shared across
+// independent apps.
+func namedMiddleware() map[string]fiber.Handler {
+	return map[string]fiber.Handler{
+		"logger":     logger.New(),
+		"recover":    recover.New(),
+		"cors":       cors.New(),
+		"request_id": requestid.New(),
+		"basic_auth": basicauth.New(basicauth.Config{}),
+		"rate_limit": limiter.New(),
+	}
+}
+
+// Setup builds a fiber app from cfg, wiring the global middleware chain,
+// registering every route in cfg.Routes against handlers (a route whose
+// handler name is not in handlers is skipped), mounting any
+// sub-apps registered with the router package, and exposing /healthz
+// and /readyz. A nil cfg falls back to config.DefaultConfig(), a nil
+// handlers falls back to handlerRegistry, and a nil probe always
+// reports ready, so Setup() keeps working for existing callers while
+// letting tests inject their own config, fakes, and readiness state.
+// dump is only wired in when cfg.Middleware.BodyDump is set; it may be
+// nil otherwise.
+func Setup(cfg *config.AppConfig, handlers map[string]fiber.Handler, probe ReadinessProbe, dump bodydump.Handler) *fiber.App {
+	if cfg == nil {
+		cfg = config.DefaultConfig()
+	}
+	if handlers == nil {
+		handlers = handlerRegistry
+	}
+	if probe == nil {
+		probe = alwaysReady{}
+	}
+
+	app := fiber.New()
+
+	app.Get("/healthz", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+	app.Get("/readyz", func(c *fiber.Ctx) error {
+		if err := probe.Ready(); err != nil {
+			return c.Status(fiber.StatusServiceUnavailable).SendString(err.Error())
+		}
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	if cfg.Middleware.BodyDump && dump != nil {
+		app.Use(bodydump.New(bodydump.Config{Handler: dump}))
+	}
+
+	if cfg.Middleware.Logger {
+		app.Use(logger.New())
+	}
+	if cfg.Middleware.Recover {
+		app.Use(recover.New())
+	}
+	if cfg.Middleware.CORS {
+		app.Use(cors.New())
+	}
+	if cfg.Middleware.RequestID {
+		app.Use(requestid.New())
+	}
+	if cfg.Middleware.BasicAuth {
+		app.Use(basicauth.New(basicauth.Config{}))
+	}
+	if cfg.Middleware.RateLimit {
+		app.Use(limiter.New())
+	}
+
+	middleware := namedMiddleware()
+
+	for _, route := range cfg.Routes {
+		handler, ok := handlers[route.Handler]
+		if !ok {
+			continue
+		}
+
+		chain := make([]fiber.Handler, 0, len(route.Middleware)+1)
+		for _, name := range route.Middleware {
+			if mw, ok := middleware[name]; ok {
+				chain = append(chain, mw)
+			}
+		}
+		chain = append(chain, handler)
+
+		app.Add(route.Method, route.Path, chain...)
+	}
+
+	router.Mount(app)
+
+	return app
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MarkTaylor-KandC/golang-microservices-fiber-example/config"
+)
+
+func TestPerRouteMiddleware(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Routes = append(cfg.Routes, config.RouteConfig{
+		Method:     "GET",
+		Path:       "/secure",
+		Handler:    "index",
+		Middleware: []string{"basic_auth"},
+	})
+
+	app := Setup(cfg, nil, nil, nil)
+
+	tests := []struct {
+		description  string
+		route        string
+		expectedCode int
+	}{
+		{
+			description:  "route without middleware is unaffected",
+			route:        "/",
+			expectedCode: 200,
+		},
+		{
+			description:  "route with basic_auth middleware rejects unauthenticated requests",
+			route:        "/secure",
+			expectedCode: 401,
+		},
+	}
+
+	for _, test := range tests {
+		req, _ := http.NewRequest("GET", test.route, nil)
+
+		res, err := app.Test(req, -1)
+
+		assert.Nilf(t, err, test.description)
+		assert.Equalf(t, test.expectedCode, res.StatusCode, test.description)
+	}
+}
+
+func TestNamedMiddlewareNotSharedAcrossSetupCalls(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Routes = append(cfg.Routes, config.RouteConfig{
+		Method:     "GET",
+		Path:       "/limited",
+		Handler:    "index",
+		Middleware: []string{"rate_limit"},
+	})
+
+	// limiter.New()'s default config allows 5 requests before returning
+	// 429. Exhaust that budget against one app, then build a second app
+	// from scratch and confirm its limiter starts fresh instead of
+	// inheriting the first app's count.
+	const limiterDefaultMax = 5
+
+	exhausted := Setup(cfg, nil, nil, nil)
+	var lastCode int
+	for i := 0; i < limiterDefaultMax+1; i++ {
+		req, _ := http.NewRequest("GET", "/limited", nil)
+		res, err := exhausted.Test(req, -1)
+		assert.Nil(t, err)
+		lastCode = res.StatusCode
+	}
+	assert.Equal(t, 429, lastCode, "the shared app should be rate limited once its budget is used up")
+
+	fresh := Setup(cfg, nil, nil, nil)
+	req, _ := http.NewRequest("GET", "/limited", nil)
+	res, err := fresh.Test(req, -1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, res.StatusCode, "a newly built app must not inherit the exhausted app's limiter state")
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MarkTaylor-KandC/golang-microservices-fiber-example/config"
+)
+
+func TestSetupWiresBodyDump(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Middleware.BodyDump = true
+
+	var gotReq, gotRes []byte
+	app := Setup(cfg, nil, nil, func(c *fiber.Ctx, reqBody, resBody []byte) {
+		gotReq = reqBody
+		gotRes = resBody
+	})
+
+	req, _ := http.NewRequest("GET", "/", nil)
+	res, err := app.Test(req, -1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	assert.Equal(t, "Hello World!", string(gotRes))
+	assert.Equal(t, "", string(gotReq))
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := DefaultConfig()
+
+	assert.True(t, cfg.Middleware.Logger)
+	assert.True(t, cfg.Middleware.Recover)
+	assert.Len(t, cfg.Routes, 1)
+	assert.Equal(t, "index", cfg.Routes[0].Handler)
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		description string
+		path        string
+		contents    string
+	}{
+		{
+			description: "missing path falls back to default",
+			path:        "",
+		},
+		{
+			description: "yaml file",
+			path:        "routes.yaml",
+			contents: "middleware:\n  logger: true\nroutes:\n  - method: GET\n    path: /ping\n    handler: ping\n",
+		},
+		{
+			description: "json file",
+			path:        "routes.json",
+			contents:    `{"middleware":{"logger":true},"routes":[{"method":"GET","path":"/ping","handler":"ping"}]}`,
+		},
+	}
+
+	dir := t.TempDir()
+
+	for _, test := range tests {
+		path := test.path
+		if path != "" {
+			path = filepath.Join(dir, test.path)
+			assert.Nilf(t, os.WriteFile(path, []byte(test.contents), 0o644), test.description)
+		}
+
+		cfg, err := Load(path)
+
+		assert.Nilf(t, err, test.description)
+		assert.NotNilf(t, cfg, test.description)
+	}
+}
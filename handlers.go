@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bufio"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// handlerRegistry maps the handler names used in config.RouteConfig to
+// the fiber.Handler that implements them. Tests can build their own
+// registry (e.g. with fakes) and pass it to Setup instead of this one.
+var handlerRegistry = map[string]fiber.Handler{
+	"index":  indexHandler,
+	"stream": streamHandler,
+	"slow":   slowHandler,
+}
+
+func indexHandler(c *fiber.Ctx) error {
+	return c.SendString("Hello World!")
+}
+
+// streamHandler writes its response in several chunks rather than a
+// single SendString, so tests can exercise clients whose body reads
+// fail partway through a response.
+func streamHandler(c *fiber.Ctx) error {
+	chunks := []string{"Hello, ", "streaming ", "World!"}
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		for _, chunk := range chunks {
+			if _, err := w.WriteString(chunk); err != nil {
+				return
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// slowHandler sleeps before responding so tests can hold a request
+// in-flight while a shutdown is triggered.
+func slowHandler(c *fiber.Ctx) error {
+	time.Sleep(300 * time.Millisecond)
+	return c.SendString("done")
+}
@@ -1,7 +1,7 @@
 package main
 
 import (
-	"io/ioutil"
+	"io"
 	"net/http"
 	"testing"
 
@@ -34,7 +34,7 @@ func TestIndexRoute(t *testing.T) {
 		},
 	}
 
-	app := Setup()
+	app := Setup(nil, nil, nil, nil)
 
 
 	for _, test := range tests {
@@ -57,7 +57,7 @@ func TestIndexRoute(t *testing.T) {
 		assert.Equalf(t, test.expectedCode, res.StatusCode, test.description)
 
 		// Read the response body
-		body, err := ioutil.ReadAll(res.Body)
+		body, err := io.ReadAll(res.Body)
 
 		// Ensure that the body was read correctly
 		assert.Nilf(t, err, test.description)
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/MarkTaylor-KandC/golang-microservices-fiber-example/config"
+)
+
+// shutdownTimeout bounds how long in-flight requests get to finish once
+// a shutdown signal arrives.
+const shutdownTimeout = 30 * time.Second
+
+func main() {
+	cfg, err := config.Load(os.Getenv("APP_CONFIG"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	probe := &shutdownProbe{}
+
+	// Use an external setup function in order
+	// to configure the app in tests as well
+	app := Setup(cfg, nil, probe, nil)
+
+	go func() {
+		// start the application on http://localhost:3000
+		if err := app.Listen(":3000"); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	// Report not-ready on /readyz before fiber stops accepting new
+	// connections, then give in-flight requests up to shutdownTimeout to
+	// finish.
+	probe.MarkShuttingDown()
+	if err := app.ShutdownWithTimeout(shutdownTimeout); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,103 @@
+// Package bodydump implements a Fiber middleware that captures request
+// and response bodies and hands them to a user-provided callback, for
+// debugging what actually went over the wire.
+package bodydump
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// DefaultMaxSize is the number of bytes captured per body when
+// Config.MaxSize is left at zero.
+const DefaultMaxSize = 4096
+
+// Handler receives the request and response bodies observed for c, each
+// truncated to Config.MaxSize bytes.
+type Handler func(c *fiber.Ctx, reqBody, resBody []byte)
+
+// Config configures New.
+type Config struct {
+	// MaxSize is the maximum number of bytes captured per body. Bodies
+	// larger than this are truncated before being passed to Handler.
+	// Defaults to DefaultMaxSize.
+	MaxSize int
+
+	// AllowedContentTypes restricts capture to responses whose
+	// Content-Type starts with one of these values. An empty list
+	// allows every content type.
+	AllowedContentTypes []string
+
+	// Handler is called once per request with the captured bodies. It
+	// is never called for streaming or multipart responses.
+	Handler Handler
+}
+
+// New returns a middleware that buffers the request and response bodies
+// of each request and passes them to cfg.Handler. The response body is
+// read from c.Response() once the rest of the chain has returned, i.e.
+// the body fasthttp is about to write; capture is skipped entirely for
+// streaming or multipart responses rather than risk buffering an
+// unbounded body.
+func New(cfg Config) fiber.Handler {
+	maxSize := cfg.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+
+	return func(c *fiber.Ctx) error {
+		reqBody := truncate(c.Body(), maxSize)
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		if cfg.Handler == nil {
+			return nil
+		}
+
+		resp := c.Response()
+		if resp.IsBodyStream() {
+			// Streaming responses are forwarded straight from the
+			// handler to fasthttp; reading them here would consume the
+			// stream before the client ever sees it.
+			return nil
+		}
+
+		contentType := string(resp.Header.ContentType())
+		if strings.HasPrefix(contentType, "multipart/") {
+			return nil
+		}
+		if !allowed(contentType, cfg.AllowedContentTypes) {
+			return nil
+		}
+
+		cfg.Handler(c, reqBody, truncate(resp.Body(), maxSize))
+		return nil
+	}
+}
+
+func truncate(body []byte, maxSize int) []byte {
+	if len(body) <= maxSize {
+		out := make([]byte, len(body))
+		copy(out, body)
+		return out
+	}
+
+	out := make([]byte, maxSize)
+	copy(out, body[:maxSize])
+	return out
+}
+
+func allowed(contentType string, allowList []string) bool {
+	if len(allowList) == 0 {
+		return true
+	}
+	for _, prefix := range allowList {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
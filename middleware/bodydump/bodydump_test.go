@@ -0,0 +1,93 @@
+package bodydump
+
+import (
+	"bufio"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		description     string
+		route           string
+		body            string
+		allowedTypes    []string
+		expectCallback  bool
+		expectedReqBody string
+		expectedResBody string
+	}{
+		{
+			description:     "captures both bodies verbatim",
+			route:           "/echo",
+			body:            "hello",
+			expectCallback:  true,
+			expectedReqBody: "hello",
+			expectedResBody: "hello:echo",
+		},
+		{
+			description:    "content type not in allowlist is skipped",
+			route:          "/echo",
+			body:           "hello",
+			allowedTypes:   []string{"application/json"},
+			expectCallback: false,
+		},
+		{
+			description:    "streaming responses are skipped",
+			route:          "/stream",
+			expectCallback: false,
+		},
+		{
+			description:    "multipart responses are skipped",
+			route:          "/multipart",
+			expectCallback: false,
+		},
+	}
+
+	for _, test := range tests {
+		var gotReq, gotRes []byte
+		called := false
+
+		app := fiber.New()
+		app.Use(New(Config{
+			AllowedContentTypes: test.allowedTypes,
+			Handler: func(c *fiber.Ctx, reqBody, resBody []byte) {
+				called = true
+				gotReq = reqBody
+				gotRes = resBody
+			},
+		}))
+		app.Post("/echo", func(c *fiber.Ctx) error {
+			return c.SendString(string(c.Body()) + ":echo")
+		})
+		app.Get("/stream", func(c *fiber.Ctx) error {
+			c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+				w.WriteString("streamed")
+				w.Flush()
+			})
+			return nil
+		})
+		app.Get("/multipart", func(c *fiber.Ctx) error {
+			c.Set(fiber.HeaderContentType, "multipart/mixed; boundary=x")
+			return c.SendString("part")
+		})
+
+		req, _ := http.NewRequest("POST", test.route, strings.NewReader(test.body))
+		if test.route != "/echo" {
+			req, _ = http.NewRequest("GET", test.route, nil)
+		}
+
+		res, err := app.Test(req, -1)
+		assert.Nilf(t, err, test.description)
+		assert.Equalf(t, 200, res.StatusCode, test.description)
+
+		assert.Equalf(t, test.expectCallback, called, test.description)
+		if test.expectCallback {
+			assert.Equalf(t, test.expectedReqBody, string(gotReq), test.description)
+			assert.Equalf(t, test.expectedResBody, string(gotRes), test.description)
+		}
+	}
+}
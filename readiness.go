@@ -0,0 +1,40 @@
+package main
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// ReadinessProbe is consulted by the /readyz handler so that downstream
+// dependencies (a database, a cache, ...) can gate traffic. Ready should
+// return nil when the service is able to serve requests, and a
+// descriptive error otherwise.
+type ReadinessProbe interface {
+	Ready() error
+}
+
+// alwaysReady is the default probe used when Setup isn't given one; it
+// never reports a problem.
+type alwaysReady struct{}
+
+func (alwaysReady) Ready() error { return nil }
+
+// shutdownProbe is the ReadinessProbe main() uses: it is ready until
+// MarkShuttingDown is called, after which /readyz starts returning 503
+// so a load balancer can stop sending it new requests while in-flight
+// ones finish.
+type shutdownProbe struct {
+	shuttingDown atomic.Bool
+}
+
+func (p *shutdownProbe) Ready() error {
+	if p.shuttingDown.Load() {
+		return errors.New("shutting down")
+	}
+	return nil
+}
+
+// MarkShuttingDown flips the probe to not-ready.
+func (p *shutdownProbe) MarkShuttingDown() {
+	p.shuttingDown.Store(true)
+}
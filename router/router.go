@@ -0,0 +1,121 @@
+// Package router pre-parses the `/{locale}/{app}/{rest...}` shape of an
+// incoming request path and dispatches it to the sub-app registered
+// under that app name, falling back to configurable defaults when the
+// locale or app segment is missing. It is built directly on top of
+// Fiber's own router (groups + path params) rather than a bespoke
+// catchall parser.
+package router
+
+import (
+	"regexp"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// localePattern matches strings shaped like "en_US". Fiber's route
+// constraints (`:param<regex(...)>`) are applied before dispatch, which
+// would mean a locale-shaped request to an app whose name happens to
+// fail the constraint never reaches this package at all; validating
+// the captured `:locale` param here instead, after routing, keeps that
+// decision in our control and doesn't depend on the router's constraint
+// syntax or its case-sensitivity settings.
+var localePattern = regexp.MustCompile(`^[a-z]{2}_[A-Z]{2}$`)
+
+// MountFunc registers the routes of a sub-app onto the group it is
+// handed; it is called once per locale/no-locale variant of the app's
+// mount point.
+type MountFunc func(r fiber.Router)
+
+// Router holds the sub-apps registered via Register along with the
+// defaults used when a request omits the locale and/or app segment.
+type Router struct {
+	DefaultLocale string
+	DefaultApp    string
+
+	names []string
+	mount map[string]MountFunc
+}
+
+// New creates a Router. defaultLocale and defaultApp are used whenever
+// a request path doesn't specify them, e.g. "/" or "/en_US/".
+func New(defaultLocale, defaultApp string) *Router {
+	return &Router{
+		DefaultLocale: defaultLocale,
+		DefaultApp:    defaultApp,
+		mount:         make(map[string]MountFunc),
+	}
+}
+
+// Register adds a sub-app under appName. mount is invoked with a
+// fiber.Router scoped to that app's mount point, both with and without a
+// leading locale segment, so it should register its routes relative to
+// the app root (e.g. r.Get("/x", handler) to serve ".../app/x").
+func (rt *Router) Register(appName string, mount MountFunc) {
+	if _, exists := rt.mount[appName]; !exists {
+		rt.names = append(rt.names, appName)
+	}
+	rt.mount[appName] = mount
+}
+
+// Mount wires every registered sub-app onto app, including the
+// locale-less and app-less shortcuts that resolve to rt.DefaultLocale
+// and rt.DefaultApp.
+func (rt *Router) Mount(app fiber.Router) {
+	for _, appName := range rt.names {
+		mount := rt.mount[appName]
+
+		rt.mountAt(app, "/"+appName, false, mount)
+		rt.mountAt(app, "/:locale/"+appName, true, mount)
+	}
+
+	// The default app's "/" and "/:locale/" groups match any path by
+	// prefix, so they go in last: mounted earlier, the "/:locale/" group
+	// would take "/billing/x" as locale "billing" and 404 it before the
+	// "billing" app's own locale-less group is reached.
+	if mount, ok := rt.mount[rt.DefaultApp]; ok {
+		rt.mountAt(app, "/", false, mount)
+		rt.mountAt(app, "/:locale/", true, mount)
+	}
+}
+
+// Default is the package-level Router that Register and Mount operate
+// on. Setup() uses it to wire whatever sub-apps have registered
+// themselves via an init() or package var, without every caller having
+// to thread a *Router through.
+var Default = New("en_US", "default")
+
+// Register adds a sub-app to the Default router. See Router.Register.
+func Register(appName string, mount MountFunc) {
+	Default.Register(appName, mount)
+}
+
+// Mount wires every sub-app registered on the Default router onto app.
+// See Router.Mount.
+func Mount(app fiber.Router) {
+	Default.Mount(app)
+}
+
+// mountAt groups app under prefix and installs the middleware that
+// resolves c.Locals("locale"). When hasLocaleParam is true, the group's
+// leading segment is only ever a locale: a request whose first segment
+// doesn't match localePattern 404s here rather than falling through
+// with a default locale, since the unconstrained `:locale` wildcard
+// would otherwise also match other registered apps' names and
+// cross-route requests between them. hasLocaleParam false means the
+// prefix has no such segment at all, so rt.DefaultLocale always
+// applies.
+func (rt *Router) mountAt(app fiber.Router, prefix string, hasLocaleParam bool, mount MountFunc) {
+	group := app.Group(prefix, func(c *fiber.Ctx) error {
+		locale := rt.DefaultLocale
+		if hasLocaleParam {
+			param := c.Params("locale")
+			if !localePattern.MatchString(param) {
+				return fiber.ErrNotFound
+			}
+			locale = param
+		}
+		c.Locals("locale", locale)
+		return c.Next()
+	})
+	mount(group)
+}
@@ -0,0 +1,96 @@
+package router
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRouter() *Router {
+	rt := New("en_US", "home")
+
+	rt.Register("home", func(r fiber.Router) {
+		r.Get("/", func(c *fiber.Ctx) error {
+			return c.SendString(c.Locals("locale").(string) + ":home")
+		})
+	})
+	rt.Register("billing", func(r fiber.Router) {
+		r.Get("/x", func(c *fiber.Ctx) error {
+			return c.SendString(c.Locals("locale").(string) + ":billing:x")
+		})
+	})
+	rt.Register("checkout", func(r fiber.Router) {
+		r.Get("/y", func(c *fiber.Ctx) error {
+			return c.SendString(c.Locals("locale").(string) + ":checkout:y")
+		})
+	})
+
+	return rt
+}
+
+func TestRouterLocaleAndAppResolution(t *testing.T) {
+	rt := newTestRouter()
+
+	app := fiber.New()
+	rt.Mount(app)
+
+	tests := []struct {
+		description  string
+		route        string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			description:  "root resolves to default locale and default app",
+			route:        "/",
+			expectedCode: 200,
+			expectedBody: "en_US:home",
+		},
+		{
+			description:  "locale-only path resolves to the default app",
+			route:        "/en_US/",
+			expectedCode: 200,
+			expectedBody: "en_US:home",
+		},
+		{
+			description:  "locale and app resolve to the registered sub-app",
+			route:        "/fr_FR/billing/x",
+			expectedCode: 200,
+			expectedBody: "fr_FR:billing:x",
+		},
+		{
+			description:  "an app name in the locale position does not cross-route into another app",
+			route:        "/billing/checkout/y",
+			expectedCode: 404,
+		},
+		{
+			description:  "a malformed locale segment 404s instead of falling back to the default locale",
+			route:        "/bogus/billing/x",
+			expectedCode: 404,
+		},
+		{
+			description:  "an app name in the locale position does not cross-route into the default app",
+			route:        "/checkout/billing/x",
+			expectedCode: 404,
+		},
+	}
+
+	for _, test := range tests {
+		req, _ := http.NewRequest("GET", test.route, nil)
+
+		res, err := app.Test(req, -1)
+		assert.Nilf(t, err, test.description)
+		assert.Equalf(t, test.expectedCode, res.StatusCode, test.description)
+
+		if test.expectedCode != 200 {
+			continue
+		}
+
+		body, err := io.ReadAll(res.Body)
+		assert.Nilf(t, err, test.description)
+		assert.Equalf(t, test.expectedBody, string(body), test.description)
+	}
+}
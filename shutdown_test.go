@@ -0,0 +1,66 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MarkTaylor-KandC/golang-microservices-fiber-example/config"
+)
+
+func TestGracefulShutdown(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Routes = append(cfg.Routes, config.RouteConfig{
+		Method:  "GET",
+		Path:    "/slow",
+		Handler: "slow",
+	})
+
+	probe := &shutdownProbe{}
+	app := Setup(cfg, nil, probe, nil)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	served := make(chan error, 1)
+	go func() {
+		served <- app.Listener(ln)
+	}()
+
+	base := "http://" + ln.Addr().String()
+
+	// Hold a request in-flight, ...
+	inFlight := make(chan *http.Response, 1)
+	go func() {
+		res, err := http.Get(base + "/slow")
+		assert.Nil(t, err)
+		inFlight <- res
+	}()
+
+	// ... give it time to reach the handler, then start shutting down.
+	time.Sleep(50 * time.Millisecond)
+	probe.MarkShuttingDown()
+
+	readyRes, err := http.Get(base + "/readyz")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, readyRes.StatusCode)
+
+	shutdownErr := make(chan error, 1)
+	go func() { shutdownErr <- app.ShutdownWithTimeout(5 * time.Second) }()
+
+	select {
+	case res := <-inFlight:
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	case <-time.After(2 * time.Second):
+		t.Fatal("in-flight request did not complete before timeout")
+	}
+
+	assert.Nil(t, <-shutdownErr)
+	assert.Nil(t, <-served)
+
+	_, err = http.Get(base + "/healthz")
+	assert.NotNil(t, err, "new connections should be refused once shut down")
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MarkTaylor-KandC/golang-microservices-fiber-example/config"
+	"github.com/MarkTaylor-KandC/golang-microservices-fiber-example/testutil"
+)
+
+func TestStreamRoute(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Routes = append(cfg.Routes, config.RouteConfig{
+		Method:  "GET",
+		Path:    "/stream",
+		Handler: "stream",
+	})
+
+	app := Setup(cfg, nil, nil, nil)
+
+	tests := []struct {
+		description string
+		failAt      int
+		expectError bool
+	}{
+		{
+			description: "full read succeeds",
+			failAt:      -1,
+			expectError: false,
+		},
+		{
+			description: "client body read fails partway through the stream",
+			failAt:      3,
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		req, _ := http.NewRequest("GET", "/stream", nil)
+
+		res, err := testutil.Do(app, req, test.failAt)
+		assert.Nilf(t, err, test.description)
+
+		body, readErr := io.ReadAll(res.Body)
+
+		if test.expectError {
+			assert.ErrorIsf(t, readErr, io.ErrUnexpectedEOF, test.description)
+		} else {
+			assert.Nilf(t, readErr, test.description)
+			assert.Equalf(t, "Hello, streaming World!", string(body), test.description)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package testutil
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Do runs req through app.Test and replaces the response body with a
+// FaultyBody over the same bytes; when failAt is non-negative that body
+// fails after failAt bytes, so the caller can assert on a client-side
+// read failure without the handler itself doing anything wrong.
+func Do(app *fiber.App, req *http.Request, failAt int) (*http.Response, error) {
+	res, err := app.Test(req, -1)
+	if err != nil {
+		return res, err
+	}
+
+	data, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return res, err
+	}
+
+	res.Body = NewFaultyBody(data, failAt)
+	return res, nil
+}
@@ -0,0 +1,60 @@
+// Package testutil helps tests exercise what happens when a client's
+// read of a response body fails partway through, something app.Test
+// alone has no way to simulate since it always hands back a body that
+// reads cleanly to EOF.
+package testutil
+
+import "io"
+
+// FaultyBody is an io.ReadCloser over a fixed byte slice that returns
+// io.ErrUnexpectedEOF once failAt bytes have been read, instead of
+// reaching a normal EOF. A negative failAt disables the fault and the
+// body reads through to completion like any other.
+type FaultyBody struct {
+	data   []byte
+	pos    int
+	failAt int
+	closed bool
+}
+
+// NewFaultyBody wraps data so that reading it fails after failAt bytes.
+func NewFaultyBody(data []byte, failAt int) *FaultyBody {
+	return &FaultyBody{data: data, failAt: failAt}
+}
+
+// Read implements io.Reader, injecting io.ErrUnexpectedEOF once pos
+// reaches failAt.
+func (b *FaultyBody) Read(p []byte) (int, error) {
+	if b.failAt >= 0 && b.pos >= b.failAt {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	remaining := len(b.data) - b.pos
+	if remaining <= 0 {
+		return 0, io.EOF
+	}
+
+	n := len(p)
+	if b.failAt >= 0 && b.pos+n > b.failAt {
+		n = b.failAt - b.pos
+	}
+	if n > remaining {
+		n = remaining
+	}
+
+	copy(p, b.data[b.pos:b.pos+n])
+	b.pos += n
+	return n, nil
+}
+
+// Close implements io.Closer. It never errors; Closed reports whether it
+// was called.
+func (b *FaultyBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+// Closed reports whether Close has been called.
+func (b *FaultyBody) Closed() bool {
+	return b.closed
+}
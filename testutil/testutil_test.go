@@ -0,0 +1,72 @@
+package testutil
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStreamingApp() *fiber.App {
+	app := fiber.New()
+	app.Get("/stream", func(c *fiber.Ctx) error {
+		return c.SendString("Hello, streaming World!")
+	})
+	return app
+}
+
+func TestDo(t *testing.T) {
+	tests := []struct {
+		description string
+		failAt      int
+		expectError bool
+	}{
+		{
+			description: "no fault injected reads the full body",
+			failAt:      -1,
+			expectError: false,
+		},
+		{
+			description: "fault beyond body length reads the full body",
+			failAt:      1 << 20,
+			expectError: false,
+		},
+		{
+			description: "fault partway through the body surfaces ErrUnexpectedEOF",
+			failAt:      5,
+			expectError: true,
+		},
+		{
+			description: "fault at offset zero fails on the first read",
+			failAt:      0,
+			expectError: true,
+		},
+	}
+
+	app := newStreamingApp()
+
+	for _, test := range tests {
+		req, _ := http.NewRequest("GET", "/stream", nil)
+
+		res, err := Do(app, req, test.failAt)
+		assert.Nilf(t, err, test.description)
+
+		body, readErr := io.ReadAll(res.Body)
+
+		if test.expectError {
+			assert.ErrorIsf(t, readErr, io.ErrUnexpectedEOF, test.description)
+		} else {
+			assert.Nilf(t, readErr, test.description)
+			assert.Equalf(t, "Hello, streaming World!", string(body), test.description)
+		}
+	}
+}
+
+func TestFaultyBodyClose(t *testing.T) {
+	b := NewFaultyBody([]byte("data"), -1)
+	assert.False(t, b.Closed())
+	assert.Nil(t, b.Close())
+	assert.True(t, b.Closed())
+}